@@ -7,125 +7,640 @@ import (
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
-type ApplicationUpdateTransactionBuilder struct {
-	ApplicationBaseTransactionBuilder
+// Limits on application creation parameters, enforced client-side so callers
+// fail fast instead of paying a fee for a transaction algod will reject.
+const (
+	// MaxAppProgramLen is the maximum length, in bytes, of an approval or
+	// clear-state program for each program page the application has: one
+	// page, plus ExtraProgramPages.
+	MaxAppProgramLen = 2048
+	// MaxExtraAppProgramPages is the maximum number of extra program pages
+	// an application may request beyond the one every application gets.
+	MaxExtraAppProgramPages = 3
+	// MaxAppGlobalSchemaEntries is the maximum total of NumUint and
+	// NumByteSlice allowed in an application's GlobalStateSchema.
+	MaxAppGlobalSchemaEntries = 64
+	// MaxAppLocalSchemaEntries is the maximum total of NumUint and
+	// NumByteSlice allowed in an application's LocalStateSchema.
+	MaxAppLocalSchemaEntries = 16
+)
+
+// ApplicationCallTxnBuilder is the chainable builder for every application
+// call this package produces: create, update, delete, opt-in, close-out,
+// clear-state, and plain no-op calls. Its OnCompletion is fixed by the
+// NewApplicationXxxTxnBuilder constructor that created it, except that OptIn
+// switches a creation builder between NoOp and OptIn; Build validates
+// whichever fields that action requires.
+//
+// Start one from NewApplicationCreateTxnBuilder, NewApplicationCallTxnBuilder,
+// NewApplicationUpdateTxnBuilder, NewApplicationDeleteTxnBuilder,
+// NewApplicationOptInTxnBuilder, NewApplicationCloseOutTxnBuilder, or
+// NewApplicationClearStateTxnBuilder.
+type ApplicationCallTxnBuilder struct {
+	TransactionBuilder
+
+	// isCreate is set only by NewApplicationCreateTxnBuilder. OptIn is
+	// rejected on any other builder, since their OnCompletion is fixed by
+	// the NewApplicationXxxTxnBuilder constructor that created them.
+	isCreate bool
 }
 
-/**
- * When creating an application, you have the option of opting in with the same transaction. Without this flag a
- * separate transaction is needed to opt-in.
- */
-func (aupd *ApplicationUpdateTransactionBuilder) optIn(optIn bool) *ApplicationUpdateTransactionBuilder {
+func newApplicationCallTxnBuilder(onCompletion types.OnCompletion) *ApplicationCallTxnBuilder {
+	b := &ApplicationCallTxnBuilder{}
+	b.txType = types.ApplicationCallTx
+	b.appCall.OnCompletion = onCompletion
+	return b
+}
 
-	if optIn {
-		aupd.OnCompletion = types.OptInOC
-	} else {
-		aupd.OnCompletion = types.NoOpOC
-	}
-	return aupd
+// NewApplicationCreateTxnBuilder starts a fluent builder for an
+// application-creation transaction. ApplicationID is left at 0, which is
+// what tells the network this is a creation rather than a call.
+func NewApplicationCreateTxnBuilder() *ApplicationCallTxnBuilder {
+	b := newApplicationCallTxnBuilder(types.NoOpOC)
+	b.isCreate = true
+	return b
 }
 
-/**
- * LocalStateSchema sets limits on the number of strings and integers that may be stored in an account's LocalState.
- * for this application. The larger these limits are, the larger minimum balance must be maintained inside the
- * account of any users who opt into this application. The LocalStateSchema is immutable.
- */
-func (aupd *ApplicationUpdateTransactionBuilder) localStateSchema(localStateSchema types.StateSchema) *ApplicationUpdateTransactionBuilder {
-	aupd.LocalStateSchema = localStateSchema
-	return aupd
+// NewApplicationCallTxnBuilder starts a fluent builder for a no-op call
+// into an existing application.
+func NewApplicationCallTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.NoOpOC)
 }
 
-/**
- * GlobalStateSchema sets limits on the number of strings and integers that may be stored in the GlobalState. The
- * larger these limits are, the larger minimum balance must be maintained inside the creator's account (in order to
- * 'pay' for the state that can be used). The GlobalStateSchema is immutable.
- */
-func (aupd *ApplicationUpdateTransactionBuilder) globalStateSchema(globalStateSchema types.StateSchema) *ApplicationUpdateTransactionBuilder {
-	aupd.GlobalStateSchema = globalStateSchema
-	return aupd
+// NewApplicationUpdateTxnBuilder starts a fluent builder for a transaction
+// that replaces an existing application's approval and clear-state
+// programs.
+func NewApplicationUpdateTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.UpdateApplicationOC)
 }
 
-func (aupd *ApplicationUpdateTransactionBuilder) build() (tx *types.Transaction) {
-	return aupd.buildBT()
+// NewApplicationDeleteTxnBuilder starts a fluent builder for a transaction
+// that deletes an application.
+func NewApplicationDeleteTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.DeleteApplicationOC)
+}
 
+// NewApplicationOptInTxnBuilder starts a fluent builder for a transaction
+// that opts the sender into an application.
+func NewApplicationOptInTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.OptInOC)
 }
 
-type ApplicationBaseTransactionBuilder struct {
-	TransactionBuilder
+// NewApplicationCloseOutTxnBuilder starts a fluent builder for a
+// transaction that closes out the sender's participation in an
+// application, removing its local state.
+func NewApplicationCloseOutTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.CloseOutOC)
 }
 
-/**
- * ApplicationID is the application being interacted with, or 0 if creating a new application.
- */
-func (abtb *ApplicationBaseTransactionBuilder) applicationId(applicationId uint64) *ApplicationBaseTransactionBuilder {
-	abtb.ApplicationID = types.AppIndex(applicationId)
-	return abtb
+// NewApplicationClearStateTxnBuilder starts a fluent builder for a
+// transaction that forcibly clears the sender's local state for an
+// application, regardless of what its approval program would otherwise
+// allow.
+func NewApplicationClearStateTxnBuilder() *ApplicationCallTxnBuilder {
+	return newApplicationCallTxnBuilder(types.ClearStateOC)
 }
 
-/**
- * This is the faux application type used to distinguish different application actions. Specifically, OnCompletion
- * specifies what side effects this transaction will have if it successfully makes it into a block.
- */
-func (abtb *ApplicationBaseTransactionBuilder) onCompletion(onCompletion types.OnCompletion) *ApplicationBaseTransactionBuilder {
-	abtb.OnCompletion = onCompletion
-	return abtb
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *ApplicationCallTxnBuilder) Sender(sender string) *ApplicationCallTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
 }
 
-/**
- * ApplicationArgs lists some transaction-specific arguments accessible from application logic.
- */
-func (abtb *ApplicationBaseTransactionBuilder) args(applicationArgs [][]byte) *ApplicationBaseTransactionBuilder {
-	abtb.ApplicationArgs = applicationArgs
-	return abtb
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *ApplicationCallTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *ApplicationCallTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
 }
 
-/**
- * ApplicationArgs lists some transaction-specific arguments accessible from application logic.
- * args List of Base64 encoded strings.
- */
-func (abtb *ApplicationBaseTransactionBuilder) argsBase64Encoded(applicationArgs []string) *ApplicationBaseTransactionBuilder {
-	for i, arg := range applicationArgs {
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *ApplicationCallTxnBuilder) Params(sp types.SuggestedParams) *ApplicationCallTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *ApplicationCallTxnBuilder) FlatFee(fee uint64) *ApplicationCallTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *ApplicationCallTxnBuilder) Note(note []byte) *ApplicationCallTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// ApplicationID sets the application being interacted with. Leave at 0 (the
+// default) when creating a new application.
+func (b *ApplicationCallTxnBuilder) ApplicationID(applicationID uint64) *ApplicationCallTxnBuilder {
+	b.appCall.ApplicationID = types.AppIndex(applicationID)
+	return b
+}
+
+// OptIn additionally opts the sender into the application as part of
+// creation, instead of requiring a separate opt-in transaction. Only valid on
+// a builder started with NewApplicationCreateTxnBuilder; calling it on any
+// other builder would silently overwrite the OnCompletion that builder's
+// constructor fixed, so it records an error instead.
+func (b *ApplicationCallTxnBuilder) OptIn(optIn bool) *ApplicationCallTxnBuilder {
+	if !b.isCreate {
+		if b.err == nil {
+			b.err = fmt.Errorf("OptIn is only valid on a builder started with NewApplicationCreateTxnBuilder")
+		}
+		return b
+	}
+	if optIn {
+		b.appCall.OnCompletion = types.OptInOC
+	} else {
+		b.appCall.OnCompletion = types.NoOpOC
+	}
+	return b
+}
 
+// Args sets the transaction-specific arguments accessible from application
+// logic.
+func (b *ApplicationCallTxnBuilder) Args(applicationArgs [][]byte) *ApplicationCallTxnBuilder {
+	b.appCall.ApplicationArgs = applicationArgs
+	return b
+}
+
+// ArgsBase64Encoded is a convenience wrapper around Args that base64-decodes
+// each element of applicationArgs first.
+func (b *ApplicationCallTxnBuilder) ArgsBase64Encoded(applicationArgs []string) *ApplicationCallTxnBuilder {
+	decoded := make([][]byte, len(applicationArgs))
+	for i, arg := range applicationArgs {
 		argB, err := base64.StdEncoding.DecodeString(arg)
 		if err != nil {
-			// Report Error
-			return nil
+			if b.err == nil {
+				b.err = fmt.Errorf("application arg %d is not valid base64: %v", i, err)
+			}
+			return b
 		}
-		abtb.ApplicationArgs[i] = argB
+		decoded[i] = argB
 	}
-	return abtb
+	b.appCall.ApplicationArgs = decoded
+	return b
 }
 
-    /**
-     * Accounts lists the accounts (in addition to the sender) that may be accessed from the application logic.
-     */
-func (abtb *ApplicationBaseTransactionBuilder) accounts (accounts []types.Address) *ApplicationBaseTransactionBuilder {
+// Accounts lists the accounts (in addition to the sender) that may be
+// accessed from the application logic.
+func (b *ApplicationCallTxnBuilder) Accounts(accounts []string) *ApplicationCallTxnBuilder {
+	decoded := make([]types.Address, len(accounts))
 	for i, acc := range accounts {
-		abtb.Accounts[i] = acc
+		addr, err := types.DecodeAddress(acc)
+		if err != nil {
+			if b.err == nil {
+				b.err = err
+			}
+			return b
+		}
+		decoded[i] = addr
 	}
-	return abtb
+	b.appCall.Accounts = decoded
+	return b
 }
 
-    /**
-     * ForeignApps lists the applications (in addition to txn.ApplicationID) whose global states may be accessed by this
-     * application. The access is read-only.
-     */
-func (abtb *ApplicationBaseTransactionBuilder) foreignApps (foreignApps []uint64) *ApplicationBaseTransactionBuilder {
+// ForeignApps lists the applications (in addition to ApplicationID) whose
+// global state may be read by this application. The access is read-only.
+func (b *ApplicationCallTxnBuilder) ForeignApps(foreignApps []uint64) *ApplicationCallTxnBuilder {
+	apps := make([]types.AppIndex, len(foreignApps))
 	for i, fa := range foreignApps {
-		abtb.ForeignApps[i] = types.AppIndex(fa)
+		apps[i] = types.AppIndex(fa)
+	}
+	b.appCall.ForeignApps = apps
+	return b
+}
+
+// ForeignAssets lists the assets whose parameters may be read by this
+// application. The access is read-only.
+func (b *ApplicationCallTxnBuilder) ForeignAssets(foreignAssets []uint64) *ApplicationCallTxnBuilder {
+	assets := make([]types.AssetIndex, len(foreignAssets))
+	for i, fa := range foreignAssets {
+		assets[i] = types.AssetIndex(fa)
 	}
-	return abtb
-    }
+	b.appCall.ForeignAssets = assets
+	return b
+}
+
+// ApprovalProgram sets the compiled TEAL program run on every application
+// call other than clear-state. Required when creating or updating an
+// application.
+func (b *ApplicationCallTxnBuilder) ApprovalProgram(program []byte) *ApplicationCallTxnBuilder {
+	b.appCall.ApprovalProgram = program
+	return b
+}
+
+// ClearStateProgram sets the compiled TEAL program run when an account
+// clears its state for this application. Required when creating or
+// updating an application.
+func (b *ApplicationCallTxnBuilder) ClearStateProgram(program []byte) *ApplicationCallTxnBuilder {
+	b.appCall.ClearStateProgram = program
+	return b
+}
+
+// LocalStateSchema sets limits on the number of strings and integers that
+// may be stored in an account's LocalState for this application. The larger
+// these limits are, the larger minimum balance must be maintained inside
+// the account of any user who opts into this application. LocalStateSchema
+// is immutable once the application is created, and only meaningful on a
+// builder started with NewApplicationCreateTxnBuilder.
+func (b *ApplicationCallTxnBuilder) LocalStateSchema(schema types.StateSchema) *ApplicationCallTxnBuilder {
+	b.appCall.LocalStateSchema = schema
+	return b
+}
 
+// GlobalStateSchema sets limits on the number of strings and integers that
+// may be stored in the application's GlobalState. The larger these limits
+// are, the larger minimum balance must be maintained inside the creator's
+// account. GlobalStateSchema is immutable once the application is created,
+// and only meaningful on a builder started with
+// NewApplicationCreateTxnBuilder.
+func (b *ApplicationCallTxnBuilder) GlobalStateSchema(schema types.StateSchema) *ApplicationCallTxnBuilder {
+	b.appCall.GlobalStateSchema = schema
+	return b
+}
 
-func (abtb *ApplicationBaseTransactionBuilder) buildBT() (tx *types.Transaction) {
-	return abtb.buildT()
+// ExtraProgramPages sets the number of additional 2KB pages allocated for
+// this application's approval and clear-state programs, beyond the first
+// page every application gets. Only meaningful on a builder started with
+// NewApplicationCreateTxnBuilder.
+func (b *ApplicationCallTxnBuilder) ExtraProgramPages(pages uint32) *ApplicationCallTxnBuilder {
+	b.appCall.ExtraProgramPages = pages
+	return b
 }
 
-func main() {
-	abtb := &ApplicationBaseTransactionBuilder{}
+// Build validates the fields required by this builder's action and returns
+// the built application-call transaction.
+func (b *ApplicationCallTxnBuilder) Build() (types.Transaction, error) {
+	creating := b.appCall.ApplicationID == 0
+	switch b.appCall.OnCompletion {
+	case types.NoOpOC:
+		if creating {
+			if len(b.appCall.ApprovalProgram) == 0 {
+				return types.Transaction{}, fmt.Errorf("application creation transaction must have an approval program")
+			}
+			if len(b.appCall.ClearStateProgram) == 0 {
+				return types.Transaction{}, fmt.Errorf("application creation transaction must have a clear state program")
+			}
+		}
+	case types.OptInOC:
+		if creating {
+			if len(b.appCall.ApprovalProgram) == 0 || len(b.appCall.ClearStateProgram) == 0 {
+				return types.Transaction{}, fmt.Errorf("application creation transaction must have an approval program and a clear state program")
+			}
+		}
+	case types.CloseOutOC, types.ClearStateOC, types.DeleteApplicationOC:
+		if creating {
+			return types.Transaction{}, fmt.Errorf("application call transaction must specify an ApplicationID")
+		}
+	case types.UpdateApplicationOC:
+		if creating {
+			return types.Transaction{}, fmt.Errorf("application update transaction must specify an ApplicationID")
+		}
+		if len(b.appCall.ApprovalProgram) == 0 || len(b.appCall.ClearStateProgram) == 0 {
+			return types.Transaction{}, fmt.Errorf("application update transaction must include an approval program and a clear state program")
+		}
+	}
 
-	abtb.applicationId(33)
-	fmt.Println(abtb)
+	maxProgramLen := MaxAppProgramLen * (1 + int(b.appCall.ExtraProgramPages))
+	if len(b.appCall.ApprovalProgram) > maxProgramLen {
+		return types.Transaction{}, fmt.Errorf("approval program too long (%d bytes, max %d)", len(b.appCall.ApprovalProgram), maxProgramLen)
+	}
+	if len(b.appCall.ClearStateProgram) > maxProgramLen {
+		return types.Transaction{}, fmt.Errorf("clear state program too long (%d bytes, max %d)", len(b.appCall.ClearStateProgram), maxProgramLen)
+	}
+	if b.appCall.ExtraProgramPages > MaxExtraAppProgramPages {
+		return types.Transaction{}, fmt.Errorf("extra program pages %d exceeds maximum of %d", b.appCall.ExtraProgramPages, MaxExtraAppProgramPages)
+	}
+	if creating {
+		if entries := b.appCall.GlobalStateSchema.NumUint + b.appCall.GlobalStateSchema.NumByteSlice; entries > MaxAppGlobalSchemaEntries {
+			return types.Transaction{}, fmt.Errorf("global state schema has %d entries, exceeds maximum of %d", entries, MaxAppGlobalSchemaEntries)
+		}
+		if entries := b.appCall.LocalStateSchema.NumUint + b.appCall.LocalStateSchema.NumByteSlice; entries > MaxAppLocalSchemaEntries {
+			return types.Transaction{}, fmt.Errorf("local state schema has %d entries, exceeds maximum of %d", entries, MaxAppLocalSchemaEntries)
+		}
+	}
+
+	return b.buildT()
+}
+
+// MakeApplicationCreateTxnFromParams constructs a transaction that creates a
+// new application, using the passed SuggestedParams in place of the
+// positional fee/validity/genesis arguments MakeApplicationCreateTxn takes.
+// See MakeApplicationCreateTxn for an explanation of the remaining
+// parameters.
+func MakeApplicationCreateTxnFromParams(sender string, params types.SuggestedParams,
+	approvalProgram, clearProgram []byte, globalSchema, localSchema types.StateSchema,
+	appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, extraPages uint32, note []byte) (types.Transaction, error) {
+	return NewApplicationCreateTxnBuilder().
+		Sender(sender).
+		ApprovalProgram(approvalProgram).
+		ClearStateProgram(clearProgram).
+		GlobalStateSchema(globalSchema).
+		LocalStateSchema(localSchema).
+		Args(appArgs).
+		Accounts(accounts).
+		ForeignApps(foreignApps).
+		ForeignAssets(foreignAssets).
+		ExtraProgramPages(extraPages).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeApplicationCreateTxn constructs a transaction that creates a new
+// application, using the passed parameters.
+// - sender is a checksummed, human-readable address which will send the transaction.
+// - feePerByte is fee per byte as received from algod SuggestedFee API call.
+// - firstRound is the first round this txn is valid (txn semantics unrelated to the application)
+// - lastRound is the last round this txn is valid
+// - note is a byte array
+// - genesisID corresponds to the id of the network
+// - genesisHash is the raw (not base64-encoded) hash of the genesis of the network
+// Application creation parameters:
+// - approvalProgram and clearProgram are the compiled TEAL programs for the application
+// - globalSchema and localSchema limit the GlobalState and LocalState a created application may use
+// - appArgs, accounts, foreignApps, and foreignAssets are accessible from the approval and clear state programs
+// - extraPages increases the available program size for this application, beyond the default single page
+func MakeApplicationCreateTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	approvalProgram, clearProgram []byte, globalSchema, localSchema types.StateSchema,
+	appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, extraPages uint32) (types.Transaction, error) {
+	return MakeApplicationCreateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, approvalProgram, clearProgram, globalSchema, localSchema, appArgs, accounts, foreignApps, foreignAssets, extraPages, note)
+}
+
+// MakeApplicationCreateTxnWithFlatFee is as MakeApplicationCreateTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationCreateTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	approvalProgram, clearProgram []byte, globalSchema, localSchema types.StateSchema,
+	appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, extraPages uint32) (types.Transaction, error) {
+	return MakeApplicationCreateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, approvalProgram, clearProgram, globalSchema, localSchema, appArgs, accounts, foreignApps, foreignAssets, extraPages, note)
+}
+
+// makeApplicationCallTxnFromParams is the shared implementation behind the
+// MakeApplicationXxxTxnFromParams family below, all of which differ only in
+// the OnCompletion they apply to an existing application.
+func makeApplicationCallTxnFromParams(onCompletion types.OnCompletion, sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return newApplicationCallTxnBuilder(onCompletion).
+		Sender(sender).
+		ApplicationID(appIdx).
+		Args(appArgs).
+		Accounts(accounts).
+		ForeignApps(foreignApps).
+		ForeignAssets(foreignAssets).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeApplicationCallTxnFromParams constructs a transaction that performs a
+// NoOp call to an existing application, using the passed SuggestedParams in
+// place of the positional fee/validity/genesis arguments MakeApplicationCallTxn
+// takes.
+func MakeApplicationCallTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return makeApplicationCallTxnFromParams(types.NoOpOC, sender, params, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationCallTxn constructs a transaction that performs a NoOp call
+// to an existing application, using the passed parameters. See
+// MakeApplicationCreateTxn for an explanation of the common parameters.
+func MakeApplicationCallTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationCallTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationCallTxnWithFlatFee is as MakeApplicationCallTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationCallTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationCallTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationOptInTxnFromParams constructs a transaction that opts the
+// sender into an existing application, using the passed SuggestedParams in
+// place of the positional fee/validity/genesis arguments MakeApplicationOptInTxn
+// takes.
+func MakeApplicationOptInTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return makeApplicationCallTxnFromParams(types.OptInOC, sender, params, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationOptInTxn constructs a transaction that opts the sender into
+// an existing application, using the passed parameters. See
+// MakeApplicationCreateTxn for an explanation of the common parameters.
+func MakeApplicationOptInTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationOptInTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationOptInTxnWithFlatFee is as MakeApplicationOptInTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationOptInTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationOptInTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationCloseOutTxnFromParams constructs a transaction that closes
+// out the sender's participation in an existing application, using the
+// passed SuggestedParams in place of the positional fee/validity/genesis
+// arguments MakeApplicationCloseOutTxn takes.
+func MakeApplicationCloseOutTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return makeApplicationCallTxnFromParams(types.CloseOutOC, sender, params, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationCloseOutTxn constructs a transaction that closes out the
+// sender's participation in an existing application, using the passed
+// parameters. See MakeApplicationCreateTxn for an explanation of the common
+// parameters.
+func MakeApplicationCloseOutTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationCloseOutTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationCloseOutTxnWithFlatFee is as MakeApplicationCloseOutTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationCloseOutTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationCloseOutTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationClearStateTxnFromParams constructs a transaction that
+// forcibly clears the sender's local state for an existing application,
+// using the passed SuggestedParams in place of the positional
+// fee/validity/genesis arguments MakeApplicationClearStateTxn takes.
+func MakeApplicationClearStateTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return makeApplicationCallTxnFromParams(types.ClearStateOC, sender, params, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationClearStateTxn constructs a transaction that forcibly clears
+// the sender's local state for an existing application, using the passed
+// parameters. See MakeApplicationCreateTxn for an explanation of the common
+// parameters.
+func MakeApplicationClearStateTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationClearStateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationClearStateTxnWithFlatFee is as MakeApplicationClearStateTxn,
+// but feePerByte is instead a flat fee.
+func MakeApplicationClearStateTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationClearStateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationDeleteTxnFromParams constructs a transaction that deletes
+// an existing application, using the passed SuggestedParams in place of the
+// positional fee/validity/genesis arguments MakeApplicationDeleteTxn takes.
+func MakeApplicationDeleteTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, note []byte) (types.Transaction, error) {
+	return makeApplicationCallTxnFromParams(types.DeleteApplicationOC, sender, params, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationDeleteTxn constructs a transaction that deletes an existing
+// application, using the passed parameters. See MakeApplicationCreateTxn for
+// an explanation of the common parameters.
+func MakeApplicationDeleteTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationDeleteTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationDeleteTxnWithFlatFee is as MakeApplicationDeleteTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationDeleteTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64) (types.Transaction, error) {
+	return MakeApplicationDeleteTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, note)
+}
+
+// MakeApplicationUpdateTxnFromParams constructs a transaction that replaces
+// an existing application's approval and clear-state programs, using the
+// passed SuggestedParams in place of the positional fee/validity/genesis
+// arguments MakeApplicationUpdateTxn takes.
+func MakeApplicationUpdateTxnFromParams(sender string, params types.SuggestedParams,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, approvalProgram, clearProgram []byte, note []byte) (types.Transaction, error) {
+	return NewApplicationUpdateTxnBuilder().
+		Sender(sender).
+		ApplicationID(appIdx).
+		Args(appArgs).
+		Accounts(accounts).
+		ForeignApps(foreignApps).
+		ForeignAssets(foreignAssets).
+		ApprovalProgram(approvalProgram).
+		ClearStateProgram(clearProgram).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeApplicationUpdateTxn constructs a transaction that replaces an
+// existing application's approval and clear-state programs, using the
+// passed parameters. See MakeApplicationCreateTxn for an explanation of the
+// common parameters.
+func MakeApplicationUpdateTxn(sender string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, approvalProgram, clearProgram []byte) (types.Transaction, error) {
+	return MakeApplicationUpdateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, approvalProgram, clearProgram, note)
+}
 
+// MakeApplicationUpdateTxnWithFlatFee is as MakeApplicationUpdateTxn, but
+// feePerByte is instead a flat fee.
+func MakeApplicationUpdateTxnWithFlatFee(sender string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash []byte,
+	appIdx uint64, appArgs [][]byte, accounts []string, foreignApps, foreignAssets []uint64, approvalProgram, clearProgram []byte) (types.Transaction, error) {
+	return MakeApplicationUpdateTxnFromParams(sender, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, appIdx, appArgs, accounts, foreignApps, foreignAssets, approvalProgram, clearProgram, note)
 }
@@ -0,0 +1,101 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+func TestMakeApplicationCreateTxn(t *testing.T) {
+	approval := []byte{1}
+	clearState := []byte{1}
+
+	tx, err := MakeApplicationCreateTxn(validAddr, 10, 1, 100, nil, "", genesisHash32,
+		approval, clearState, types.StateSchema{}, types.StateSchema{}, nil, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.ApplicationID != 0 {
+		t.Fatalf("expected ApplicationID 0 for a creation transaction, got %d", tx.ApplicationID)
+	}
+	if tx.OnCompletion != types.NoOpOC {
+		t.Fatalf("expected NoOpOC, got %v", tx.OnCompletion)
+	}
+}
+
+func TestMakeApplicationCreateTxnWithFlatFee(t *testing.T) {
+	tx, err := MakeApplicationCreateTxnWithFlatFee(validAddr, 5000, 1, 100, nil, "", genesisHash32,
+		[]byte{1}, []byte{1}, types.StateSchema{}, types.StateSchema{}, nil, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
+
+func TestMakeApplicationCallFamilyOnCompletion(t *testing.T) {
+	cases := []struct {
+		name    string
+		makeTxn func() (types.Transaction, error)
+		want    types.OnCompletion
+	}{
+		{"call", func() (types.Transaction, error) {
+			return MakeApplicationCallTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+		}, types.NoOpOC},
+		{"opt in", func() (types.Transaction, error) {
+			return MakeApplicationOptInTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+		}, types.OptInOC},
+		{"close out", func() (types.Transaction, error) {
+			return MakeApplicationCloseOutTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+		}, types.CloseOutOC},
+		{"clear state", func() (types.Transaction, error) {
+			return MakeApplicationClearStateTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+		}, types.ClearStateOC},
+		{"delete", func() (types.Transaction, error) {
+			return MakeApplicationDeleteTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+		}, types.DeleteApplicationOC},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tx, err := c.makeTxn()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.ApplicationID != 1 {
+				t.Fatalf("expected ApplicationID 1, got %d", tx.ApplicationID)
+			}
+			if tx.OnCompletion != c.want {
+				t.Fatalf("expected OnCompletion %v, got %v", c.want, tx.OnCompletion)
+			}
+		})
+	}
+}
+
+func TestMakeApplicationUpdateTxn(t *testing.T) {
+	tx, err := MakeApplicationUpdateTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil, []byte{1}, []byte{1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.OnCompletion != types.UpdateApplicationOC {
+		t.Fatalf("expected UpdateApplicationOC, got %v", tx.OnCompletion)
+	}
+
+	t.Run("requires approval and clear programs", func(t *testing.T) {
+		_, err := MakeApplicationUpdateTxn(validAddr, 10, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil, nil, nil)
+		if err == nil {
+			t.Fatal("expected an error when updating without approval/clear programs")
+		}
+	})
+}
+
+func TestMakeApplicationCallTxnWithFlatFee(t *testing.T) {
+	tx, err := MakeApplicationCallTxnWithFlatFee(validAddr, 5000, 1, 100, nil, "", genesisHash32, 1, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
@@ -0,0 +1,576 @@
+package transaction
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// AssetCreateTxnBuilder builds an asset-creation transaction. Start one with
+// NewAssetCreateTxnBuilder.
+type AssetCreateTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewAssetCreateTxnBuilder starts a fluent builder for an asset-creation
+// transaction.
+func NewAssetCreateTxnBuilder() *AssetCreateTxnBuilder {
+	b := &AssetCreateTxnBuilder{}
+	b.txType = types.AssetConfigTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *AssetCreateTxnBuilder) Sender(sender string) *AssetCreateTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *AssetCreateTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *AssetCreateTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *AssetCreateTxnBuilder) Params(sp types.SuggestedParams) *AssetCreateTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *AssetCreateTxnBuilder) FlatFee(fee uint64) *AssetCreateTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *AssetCreateTxnBuilder) Note(note []byte) *AssetCreateTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// Total sets the total number of base units of the asset to create.
+func (b *AssetCreateTxnBuilder) Total(total uint64) *AssetCreateTxnBuilder {
+	b.assetConfig.AssetParams.Total = total
+	return b
+}
+
+// DefaultFrozen sets whether slots for this asset in user accounts are
+// frozen by default.
+func (b *AssetCreateTxnBuilder) DefaultFrozen(defaultFrozen bool) *AssetCreateTxnBuilder {
+	b.assetConfig.AssetParams.DefaultFrozen = defaultFrozen
+	return b
+}
+
+// Manager sets the address allowed to reconfigure or destroy the asset.
+func (b *AssetCreateTxnBuilder) Manager(manager string) *AssetCreateTxnBuilder {
+	if manager == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(manager)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Manager = addr
+	return b
+}
+
+// Reserve sets the address holding reserve (non-minted) units of the asset.
+func (b *AssetCreateTxnBuilder) Reserve(reserve string) *AssetCreateTxnBuilder {
+	if reserve == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(reserve)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Reserve = addr
+	return b
+}
+
+// Freeze sets the address allowed to freeze or unfreeze holdings of the
+// asset.
+func (b *AssetCreateTxnBuilder) Freeze(freeze string) *AssetCreateTxnBuilder {
+	if freeze == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(freeze)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Freeze = addr
+	return b
+}
+
+// Clawback sets the address allowed to revoke holdings of the asset from
+// any account.
+func (b *AssetCreateTxnBuilder) Clawback(clawback string) *AssetCreateTxnBuilder {
+	if clawback == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(clawback)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Clawback = addr
+	return b
+}
+
+// UnitName sets the short name for units of this asset.
+func (b *AssetCreateTxnBuilder) UnitName(unitName string) *AssetCreateTxnBuilder {
+	if len(unitName) > len(b.assetConfig.AssetParams.UnitName) {
+		if b.err == nil {
+			b.err = fmt.Errorf("asset unit name %s too long (max %d bytes)", unitName, len(b.assetConfig.AssetParams.UnitName))
+		}
+		return b
+	}
+	copy(b.assetConfig.AssetParams.UnitName[:], []byte(unitName))
+	return b
+}
+
+// AssetName sets the full name of this asset.
+func (b *AssetCreateTxnBuilder) AssetName(assetName string) *AssetCreateTxnBuilder {
+	if len(assetName) > len(b.assetConfig.AssetParams.AssetName) {
+		if b.err == nil {
+			b.err = fmt.Errorf("asset name %s too long (max %d bytes)", assetName, len(b.assetConfig.AssetParams.AssetName))
+		}
+		return b
+	}
+	copy(b.assetConfig.AssetParams.AssetName[:], []byte(assetName))
+	return b
+}
+
+// URL sets a URL associated with the asset.
+func (b *AssetCreateTxnBuilder) URL(url string) *AssetCreateTxnBuilder {
+	if len(url) > len(b.assetConfig.AssetParams.URL) {
+		if b.err == nil {
+			b.err = fmt.Errorf("asset URL %s too long (max %d bytes)", url, len(b.assetConfig.AssetParams.URL))
+		}
+		return b
+	}
+	copy(b.assetConfig.AssetParams.URL[:], []byte(url))
+	return b
+}
+
+// Decimals sets the number of digits to use after the decimal point when
+// displaying this asset.
+func (b *AssetCreateTxnBuilder) Decimals(decimals uint32) *AssetCreateTxnBuilder {
+	b.assetConfig.AssetParams.Decimals = decimals
+	return b
+}
+
+// Build validates the required fields and returns the built asset-creation
+// transaction.
+func (b *AssetCreateTxnBuilder) Build() (types.Transaction, error) {
+	return b.buildT()
+}
+
+// AssetConfigTxnBuilder builds an asset reconfiguration (or destroy)
+// transaction. Start one with NewAssetConfigTxnBuilder.
+type AssetConfigTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewAssetConfigTxnBuilder starts a fluent builder for an asset
+// reconfiguration transaction.
+func NewAssetConfigTxnBuilder() *AssetConfigTxnBuilder {
+	b := &AssetConfigTxnBuilder{}
+	b.txType = types.AssetConfigTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *AssetConfigTxnBuilder) Sender(sender string) *AssetConfigTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *AssetConfigTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *AssetConfigTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *AssetConfigTxnBuilder) Params(sp types.SuggestedParams) *AssetConfigTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *AssetConfigTxnBuilder) FlatFee(fee uint64) *AssetConfigTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *AssetConfigTxnBuilder) Note(note []byte) *AssetConfigTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// ConfigAsset sets the asset being reconfigured or destroyed.
+func (b *AssetConfigTxnBuilder) ConfigAsset(assetIndex uint64) *AssetConfigTxnBuilder {
+	b.assetConfig.ConfigAsset = types.AssetIndex(assetIndex)
+	return b
+}
+
+// Manager sets the new manager address. A reconfiguration transaction
+// replaces all four addresses at once, so pass the current value of any
+// address that should stay the same; an empty string clears it permanently.
+func (b *AssetConfigTxnBuilder) Manager(manager string) *AssetConfigTxnBuilder {
+	if manager == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(manager)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Manager = addr
+	return b
+}
+
+// Reserve sets the new reserve address. See Manager for the semantics of an
+// empty string.
+func (b *AssetConfigTxnBuilder) Reserve(reserve string) *AssetConfigTxnBuilder {
+	if reserve == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(reserve)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Reserve = addr
+	return b
+}
+
+// Freeze sets the new freeze address. See Manager for the semantics of an
+// empty string.
+func (b *AssetConfigTxnBuilder) Freeze(freeze string) *AssetConfigTxnBuilder {
+	if freeze == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(freeze)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Freeze = addr
+	return b
+}
+
+// Clawback sets the new clawback address. See Manager for the semantics of
+// an empty string.
+func (b *AssetConfigTxnBuilder) Clawback(clawback string) *AssetConfigTxnBuilder {
+	if clawback == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(clawback)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetConfig.AssetParams.Clawback = addr
+	return b
+}
+
+// Build validates the required fields and returns the built asset
+// reconfiguration transaction.
+func (b *AssetConfigTxnBuilder) Build() (types.Transaction, error) {
+	if b.assetConfig.ConfigAsset == 0 {
+		return types.Transaction{}, fmt.Errorf("asset config transaction must specify a ConfigAsset")
+	}
+	return b.buildT()
+}
+
+// AssetDestroyTxnBuilder builds an asset-destroy transaction. Start one
+// with NewAssetDestroyTxnBuilder.
+type AssetDestroyTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewAssetDestroyTxnBuilder starts a fluent builder for an asset-destroy
+// transaction.
+func NewAssetDestroyTxnBuilder() *AssetDestroyTxnBuilder {
+	b := &AssetDestroyTxnBuilder{}
+	b.txType = types.AssetConfigTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *AssetDestroyTxnBuilder) Sender(sender string) *AssetDestroyTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *AssetDestroyTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *AssetDestroyTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *AssetDestroyTxnBuilder) Params(sp types.SuggestedParams) *AssetDestroyTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *AssetDestroyTxnBuilder) FlatFee(fee uint64) *AssetDestroyTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *AssetDestroyTxnBuilder) Note(note []byte) *AssetDestroyTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// ConfigAsset sets the asset to destroy.
+func (b *AssetDestroyTxnBuilder) ConfigAsset(assetIndex uint64) *AssetDestroyTxnBuilder {
+	b.assetConfig.ConfigAsset = types.AssetIndex(assetIndex)
+	return b
+}
+
+// Build validates the required fields and returns the built asset-destroy
+// transaction. AssetParams is left zeroed, as required to destroy an asset.
+func (b *AssetDestroyTxnBuilder) Build() (types.Transaction, error) {
+	if b.assetConfig.ConfigAsset == 0 {
+		return types.Transaction{}, fmt.Errorf("asset destroy transaction must specify a ConfigAsset")
+	}
+	b.assetConfig.AssetParams = types.AssetParams{}
+	return b.buildT()
+}
+
+// AssetTransferTxnBuilder builds an asset-transfer transaction, including
+// opt-in (zero-amount self-transfer) and clawback (revocation) transfers.
+// Start one with NewAssetTransferTxnBuilder.
+type AssetTransferTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewAssetTransferTxnBuilder starts a fluent builder for an asset-transfer
+// transaction.
+func NewAssetTransferTxnBuilder() *AssetTransferTxnBuilder {
+	b := &AssetTransferTxnBuilder{}
+	b.txType = types.AssetTransferTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *AssetTransferTxnBuilder) Sender(sender string) *AssetTransferTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *AssetTransferTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *AssetTransferTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *AssetTransferTxnBuilder) Params(sp types.SuggestedParams) *AssetTransferTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *AssetTransferTxnBuilder) FlatFee(fee uint64) *AssetTransferTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *AssetTransferTxnBuilder) Note(note []byte) *AssetTransferTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// XferAsset sets the asset being transferred.
+func (b *AssetTransferTxnBuilder) XferAsset(assetIndex uint64) *AssetTransferTxnBuilder {
+	b.assetXfer.XferAsset = types.AssetIndex(assetIndex)
+	return b
+}
+
+// AssetAmount sets the number of base units of the asset to transfer. Use 0
+// to opt in when AssetReceiver equals Sender.
+func (b *AssetTransferTxnBuilder) AssetAmount(amount uint64) *AssetTransferTxnBuilder {
+	b.assetXfer.AssetAmount = amount
+	return b
+}
+
+// AssetReceiver sets the address receiving the asset.
+func (b *AssetTransferTxnBuilder) AssetReceiver(receiver string) *AssetTransferTxnBuilder {
+	addr, err := types.DecodeAddress(receiver)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetXfer.AssetReceiver = addr
+	return b
+}
+
+// AssetCloseTo closes out the sender's holding of the asset, sending its
+// entire remaining balance to the given address.
+func (b *AssetTransferTxnBuilder) AssetCloseTo(closeTo string) *AssetTransferTxnBuilder {
+	if closeTo == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(closeTo)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetXfer.AssetCloseTo = addr
+	return b
+}
+
+// AssetSender, together with Sender being the clawback address, revokes
+// AssetAmount base units of the asset from AssetSender's holding and moves
+// them to AssetReceiver.
+func (b *AssetTransferTxnBuilder) AssetSender(assetSender string) *AssetTransferTxnBuilder {
+	if assetSender == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(assetSender)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetXfer.AssetSender = addr
+	return b
+}
+
+// Build validates the required fields and returns the built asset-transfer
+// transaction.
+func (b *AssetTransferTxnBuilder) Build() (types.Transaction, error) {
+	if b.assetXfer.XferAsset == 0 {
+		return types.Transaction{}, fmt.Errorf("asset transfer transaction must specify an XferAsset")
+	}
+	return b.buildT()
+}
+
+// AssetFreezeTxnBuilder builds an asset-freeze (or unfreeze) transaction.
+// Start one with NewAssetFreezeTxnBuilder.
+type AssetFreezeTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewAssetFreezeTxnBuilder starts a fluent builder for an asset-freeze
+// transaction.
+func NewAssetFreezeTxnBuilder() *AssetFreezeTxnBuilder {
+	b := &AssetFreezeTxnBuilder{}
+	b.txType = types.AssetFreezeTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *AssetFreezeTxnBuilder) Sender(sender string) *AssetFreezeTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *AssetFreezeTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *AssetFreezeTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *AssetFreezeTxnBuilder) Params(sp types.SuggestedParams) *AssetFreezeTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *AssetFreezeTxnBuilder) FlatFee(fee uint64) *AssetFreezeTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *AssetFreezeTxnBuilder) Note(note []byte) *AssetFreezeTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// FreezeAccount sets the account whose asset holding is being frozen or
+// unfrozen.
+func (b *AssetFreezeTxnBuilder) FreezeAccount(account string) *AssetFreezeTxnBuilder {
+	addr, err := types.DecodeAddress(account)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.assetFreeze.FreezeAccount = addr
+	return b
+}
+
+// FreezeAsset sets the asset being frozen or unfrozen.
+func (b *AssetFreezeTxnBuilder) FreezeAsset(assetIndex uint64) *AssetFreezeTxnBuilder {
+	b.assetFreeze.FreezeAsset = types.AssetIndex(assetIndex)
+	return b
+}
+
+// AssetFrozen sets the new frozen status of FreezeAccount's holding.
+func (b *AssetFreezeTxnBuilder) AssetFrozen(frozen bool) *AssetFreezeTxnBuilder {
+	b.assetFreeze.AssetFrozen = frozen
+	return b
+}
+
+// Build validates the required fields and returns the built asset-freeze
+// transaction.
+func (b *AssetFreezeTxnBuilder) Build() (types.Transaction, error) {
+	if b.assetFreeze.FreezeAsset == 0 {
+		return types.Transaction{}, fmt.Errorf("asset freeze transaction must specify a FreezeAsset")
+	}
+	return b.buildT()
+}
@@ -0,0 +1,138 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+const genesisHashB64 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+func TestMakeAssetTransferTxn(t *testing.T) {
+	tx, err := MakeAssetTransferTxn(validAddr, otherAddr, "", 10, 5, 10, 1, 100, nil, "", genesisHashB64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.XferAsset != 5 {
+		t.Fatalf("expected XferAsset 5, got %d", tx.XferAsset)
+	}
+	if tx.AssetAmount != 10 {
+		t.Fatalf("expected AssetAmount 10, got %d", tx.AssetAmount)
+	}
+}
+
+func TestMakeAssetTransferTxnWithFlatFee(t *testing.T) {
+	tx, err := MakeAssetTransferTxnWithFlatFee(validAddr, otherAddr, "", 10, 5, 5000, 1, 100, nil, "", genesisHashB64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
+
+func TestMakeAssetAcceptanceTxn(t *testing.T) {
+	tx, err := MakeAssetAcceptanceTxn(validAddr, 5, 10, 1, 100, nil, "", genesisHashB64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.AssetAmount != 0 {
+		t.Fatalf("expected a zero-amount opt-in transfer, got amount %d", tx.AssetAmount)
+	}
+	if tx.AssetReceiver != tx.Sender {
+		t.Fatal("expected an opt-in transaction to self-transfer")
+	}
+}
+
+func TestMakeAssetRevocationTxn(t *testing.T) {
+	tx, err := MakeAssetRevocationTxn(validAddr, otherAddr, 10, validAddr, 5, 10, 1, 100, nil, "", genesisHashB64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantSender, _ := types.DecodeAddress(otherAddr)
+	if tx.AssetSender != wantSender {
+		t.Fatal("expected AssetSender to be the account the holding is revoked from")
+	}
+}
+
+func TestMakeAssetFreezeTxn(t *testing.T) {
+	tx, err := MakeAssetFreezeTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 5, otherAddr, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tx.AssetFrozen {
+		t.Fatal("expected AssetFrozen to be true")
+	}
+
+	t.Run("requires FreezeAsset", func(t *testing.T) {
+		_, err := MakeAssetFreezeTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 0, otherAddr, true)
+		if err == nil {
+			t.Fatal("expected an error when FreezeAsset is 0")
+		}
+	})
+}
+
+func TestMakeAssetConfigTxnPreservesWhatItIsGiven(t *testing.T) {
+	tx, err := MakeAssetConfigTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 5, validAddr, otherAddr, validAddr, otherAddr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantManager, _ := types.DecodeAddress(validAddr)
+	wantReserve, _ := types.DecodeAddress(otherAddr)
+	if tx.AssetParams.Manager != wantManager {
+		t.Fatal("expected Manager to be set to the passed address")
+	}
+	if tx.AssetParams.Reserve != wantReserve {
+		t.Fatal("expected Reserve to be set to the passed address")
+	}
+
+	t.Run("empty address clears the field", func(t *testing.T) {
+		tx, err := MakeAssetConfigTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 5, "", otherAddr, validAddr, otherAddr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.AssetParams.Manager != (types.Address{}) {
+			t.Fatal("expected an empty manager string to leave Manager zeroed")
+		}
+	})
+}
+
+func TestMakeAssetConfigTxnWithFlatFee(t *testing.T) {
+	tx, err := MakeAssetConfigTxnWithFlatFee(validAddr, 5000, 1, 100, nil, "", genesisHashB64, 5, validAddr, otherAddr, validAddr, otherAddr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
+
+func TestMakeAssetDestroyTxnZeroesAssetParams(t *testing.T) {
+	tx, err := MakeAssetDestroyTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.ConfigAsset != 5 {
+		t.Fatalf("expected ConfigAsset 5, got %d", tx.ConfigAsset)
+	}
+	if tx.AssetParams != (types.AssetParams{}) {
+		t.Fatal("expected AssetParams to be zeroed, as the protocol requires to destroy an asset")
+	}
+}
+
+func TestMakeAssetDestroyTxnWithFlatFee(t *testing.T) {
+	tx, err := MakeAssetDestroyTxnWithFlatFee(validAddr, 5000, 1, 100, nil, "", genesisHashB64, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
+
+func TestMakeAssetCreateTxnBadGenesisHash(t *testing.T) {
+	_, err := MakeAssetCreateTxn(validAddr, 10, 1, 100, nil, "", "not-valid-base64!!", 100, false, validAddr, validAddr, validAddr, validAddr, "U", "Asset")
+	if err == nil {
+		t.Fatal("expected an error for a malformed base64 genesis hash")
+	}
+}
@@ -11,88 +11,68 @@ import (
 // MinTxnFee is v5 consensus params, in microAlgos
 const MinTxnFee = 1000
 
+// MakePaymentTxnFromParams constructs a payment transaction using the
+// passed SuggestedParams, in place of the positional fee/validity/genesis
+// arguments MakePaymentTxn takes.
+// `from` and `to` addresses should be checksummed, human-readable addresses
+func MakePaymentTxnFromParams(from string, params types.SuggestedParams, to string, amount uint64, closeRemainderTo string, note []byte) (types.Transaction, error) {
+	return NewPaymentTxnBuilder().
+		Sender(from).
+		Receiver(to).
+		Amount(amount).
+		CloseRemainderTo(closeRemainderTo).
+		Params(params).
+		Note(note).
+		Build()
+}
+
 // MakePaymentTxn constructs a payment transaction using the passed parameters.
 // `from` and `to` addresses should be checksummed, human-readable addresses
 // fee is fee per byte as received from algod SuggestedFee API call
 func MakePaymentTxn(from, to string, fee, amount, firstRound, lastRound uint64, note []byte, closeRemainderTo, genesisID string, genesisHash []byte) (types.Transaction, error) {
-	// Decode from address
-	fromAddr, err := types.DecodeAddress(from)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	// Decode to address
-	toAddr, err := types.DecodeAddress(to)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	// Decode the CloseRemainderTo address, if present
-	var closeRemainderToAddr types.Address
-	if closeRemainderTo != "" {
-		closeRemainderToAddr, err = types.DecodeAddress(closeRemainderTo)
-		if err != nil {
-			return types.Transaction{}, err
-		}
-	}
-
-	// Decode GenesisHash
-	if len(genesisHash) == 0 {
-		return types.Transaction{}, fmt.Errorf("payment transaction must contain a genesisHash")
-	}
-
-	var gh types.Digest
-	copy(gh[:], genesisHash)
-
-	// Build the transaction
-	tx := types.Transaction{
-		Type: types.PaymentTx,
-		Header: types.Header{
-			Sender:      fromAddr,
-			Fee:         types.MicroAlgos(fee),
-			FirstValid:  types.Round(firstRound),
-			LastValid:   types.Round(lastRound),
-			Note:        note,
-			GenesisID:   genesisID,
-			GenesisHash: gh,
-		},
-		PaymentTxnFields: types.PaymentTxnFields{
-			Receiver:         toAddr,
-			Amount:           types.MicroAlgos(amount),
-			CloseRemainderTo: closeRemainderToAddr,
-		},
-	}
-
-	// Update fee
-	eSize, err := estimateSize(tx)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-	tx.Fee = types.MicroAlgos(eSize * fee)
-
-	if tx.Fee < MinTxnFee {
-		tx.Fee = MinTxnFee
-	}
-
-	return tx, nil
+	return MakePaymentTxnFromParams(from, types.SuggestedParams{
+		Fee:             fee,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, to, amount, closeRemainderTo, note)
 }
 
 // MakePaymentTxnWithFlatFee constructs a payment transaction using the passed parameters.
 // `from` and `to` addresses should be checksummed, human-readable addresses
 // fee is a flat fee
 func MakePaymentTxnWithFlatFee(from, to string, fee, amount, firstRound, lastRound uint64, note []byte, closeRemainderTo, genesisID string, genesisHash []byte) (types.Transaction, error) {
-	// Decode from address
-	tx, err := MakePaymentTxn(from, to, fee, amount, firstRound, lastRound, note, closeRemainderTo, genesisID, genesisHash)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-	tx.Fee = types.MicroAlgos(fee)
-
-	if tx.Fee < MinTxnFee {
-		tx.Fee = MinTxnFee
-	}
+	return MakePaymentTxnFromParams(from, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     genesisHash,
+	}, to, amount, closeRemainderTo, note)
+}
 
-	return tx, nil
+// MakeKeyRegTxnFromParams constructs a keyreg transaction using the passed
+// SuggestedParams, in place of the positional fee/validity/genesis arguments
+// MakeKeyRegTxn takes.
+// - account is a checksummed, human-readable address for which we register the given participation key.
+// - voteKey is a base64-encoded string corresponding to the root participation public key
+// - selectionKey is a base64-encoded string corresponding to the vrf public key
+// - voteFirst is the first round this participation key is valid
+// - voteLast is the last round this participation key is valid
+// - voteKeyDilution is the dilution for the 2-level participation key
+func MakeKeyRegTxnFromParams(account string, params types.SuggestedParams, voteKey, selectionKey string, voteFirst, voteLast, voteKeyDilution uint64, note []byte) (types.Transaction, error) {
+	return NewKeyRegTxnBuilder().
+		Sender(account).
+		VoteKey(voteKey).
+		SelectionKey(selectionKey).
+		VoteFirst(voteFirst).
+		VoteLast(voteLast).
+		VoteKeyDilution(voteKeyDilution).
+		Params(params).
+		Note(note).
+		Build()
 }
 
 // MakeKeyRegTxn constructs a keyreg transaction using the passed parameters.
@@ -111,59 +91,18 @@ func MakePaymentTxnWithFlatFee(from, to string, fee, amount, firstRound, lastRou
 // - voteKeyDilution is the dilution for the 2-level participation key
 func MakeKeyRegTxn(account string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash string,
 	voteKey, selectionKey string, voteFirst, voteLast, voteKeyDilution uint64) (types.Transaction, error) {
-	// Decode account address
-	accountAddr, err := types.DecodeAddress(account)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
 	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
 
-	votePKBytes, err := byte32FromBase64(voteKey)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	selectionPKBytes, err := byte32FromBase64(selectionKey)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	tx := types.Transaction{
-		Type: types.KeyRegistrationTx,
-		Header: types.Header{
-			Sender:      accountAddr,
-			Fee:         types.MicroAlgos(feePerByte),
-			FirstValid:  types.Round(firstRound),
-			LastValid:   types.Round(lastRound),
-			Note:        note,
-			GenesisHash: types.Digest(ghBytes),
-			GenesisID:   genesisID,
-		},
-		KeyregTxnFields: types.KeyregTxnFields{
-			VotePK:          types.VotePK(votePKBytes),
-			SelectionPK:     types.VRFPK(selectionPKBytes),
-			VoteFirst:       types.Round(voteFirst),
-			VoteLast:        types.Round(voteLast),
-			VoteKeyDilution: voteKeyDilution,
-		},
-	}
-
-	// Update fee
-	eSize, err := estimateSize(tx)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-	tx.Fee = types.MicroAlgos(eSize * feePerByte)
-
-	if tx.Fee < MinTxnFee {
-		tx.Fee = MinTxnFee
-	}
-
-	return tx, nil
+	return MakeKeyRegTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, voteKey, selectionKey, voteFirst, voteLast, voteKeyDilution, note)
 }
 
 // MakeKeyRegTxnWithFlatFee constructs a keyreg transaction using the passed parameters.
@@ -182,18 +121,42 @@ func MakeKeyRegTxn(account string, feePerByte, firstRound, lastRound uint64, not
 // - voteKeyDilution is the dilution for the 2-level participation key
 func MakeKeyRegTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash string,
 	voteKey, selectionKey string, voteFirst, voteLast, voteKeyDilution uint64) (types.Transaction, error) {
-	tx, err := MakeKeyRegTxn(account, fee, firstRound, lastRound, note, genesisID, genesisHash, voteKey, selectionKey, voteFirst, voteLast, voteKeyDilution)
+	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
 
-	tx.Fee = types.MicroAlgos(fee)
-
-	if tx.Fee < MinTxnFee {
-		tx.Fee = MinTxnFee
-	}
+	return MakeKeyRegTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, voteKey, selectionKey, voteFirst, voteLast, voteKeyDilution, note)
+}
 
-	return tx, nil
+// MakeAssetCreateTxnFromParams constructs an asset creation transaction
+// using the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetCreateTxn takes.
+// - account is a checksummed, human-readable address which will send the transaction.
+// Asset creation parameters:
+// - see asset.go
+func MakeAssetCreateTxnFromParams(account string, params types.SuggestedParams,
+	total uint64, defaultFrozen bool, manager string, reserve string, freeze string, clawback string, unitName string, assetName string, note []byte) (types.Transaction, error) {
+	return NewAssetCreateTxnBuilder().
+		Sender(account).
+		Total(total).
+		DefaultFrozen(defaultFrozen).
+		Manager(manager).
+		Reserve(reserve).
+		Freeze(freeze).
+		Clawback(clawback).
+		UnitName(unitName).
+		AssetName(assetName).
+		Params(params).
+		Note(note).
+		Build()
 }
 
 // MakeAssetCreateTxn constructs an asset creation transaction using the passed parameters.
@@ -208,105 +171,332 @@ func MakeKeyRegTxnWithFlatFee(account string, fee, firstRound, lastRound uint64,
 // - see asset.go
 func MakeAssetCreateTxn(account string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash string,
 	total uint64, defaultFrozen bool, manager string, reserve string, freeze string, clawback string, unitName string, assetName string) (types.Transaction, error) {
-	var tx types.Transaction
-	var err error
-
-	tx.Type = types.AssetConfigTx
-	tx.AssetParams = types.AssetParams{
-		Total:         total,
-		DefaultFrozen: defaultFrozen,
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	if manager != "" {
-		tx.AssetParams.Manager, err = types.DecodeAddress(manager)
-		if err != nil {
-			return tx, err
-		}
-	}
+	return MakeAssetCreateTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, total, defaultFrozen, manager, reserve, freeze, clawback, unitName, assetName, note)
+}
 
-	if reserve != "" {
-		tx.AssetParams.Reserve, err = types.DecodeAddress(reserve)
-		if err != nil {
-			return tx, err
-		}
+// MakeAssetCreateTxnWithFlatFee constructs an asset creation transaction using the passed parameters.
+// - account is a checksummed, human-readable address which will send the transaction.
+// - fee is a flat fee
+// - firstRound is the first round this txn is valid (txn semantics unrelated to the asset)
+// - lastRound is the last round this txn is valid
+// - genesis id corresponds to the id of the network
+// - genesis hash corresponds to the base64-encoded hash of the genesis of the network
+// Asset creation parameters:
+// - see asset.go
+func MakeAssetCreateTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash string,
+	total uint64, defaultFrozen bool, manager string, reserve string, freeze string, clawback string, unitName string, assetName string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	if freeze != "" {
-		tx.AssetParams.Freeze, err = types.DecodeAddress(freeze)
-		if err != nil {
-			return tx, err
-		}
+	return MakeAssetCreateTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, total, defaultFrozen, manager, reserve, freeze, clawback, unitName, assetName, note)
+}
+
+// MakeAssetTransferTxn creates a transaction that transfers asset units from
+// one account to another, using the passed parameters.
+// - account is a checksummed, human-readable address which will send the transaction and the asset.
+// - recipient is a checksummed, human-readable address which will receive the asset.
+// - closeAssetsTo is a checksummed, human-readable address; if non-empty, the sender's entire remaining holding of the asset is also transferred to it.
+// - amount is the number of asset base units to transfer.
+// - assetIndex is the asset being transferred.
+// - feePerByte is fee per byte as received from algod SuggestedFee API call.
+// - firstRound is the first round this txn is valid
+// - lastRound is the last round this txn is valid
+// - note is a byte array
+// - genesis id corresponds to the id of the network
+// - genesis hash corresponds to the base64-encoded hash of the genesis of the network
+func MakeAssetTransferTxn(account, recipient, closeAssetsTo string, amount uint64, assetIndex uint64, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	if clawback != "" {
-		tx.AssetParams.Clawback, err = types.DecodeAddress(clawback)
-		if err != nil {
-			return tx, err
-		}
+	return MakeAssetTransferTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, recipient, closeAssetsTo, amount, assetIndex, note)
+}
+
+// MakeAssetTransferTxnWithFlatFee is as MakeAssetTransferTxn, but feePerByte
+// is instead a flat fee.
+func MakeAssetTransferTxnWithFlatFee(account, recipient, closeAssetsTo string, amount uint64, assetIndex uint64, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	if len(unitName) > len(tx.AssetParams.UnitName) {
-		return tx, fmt.Errorf("asset unit name %s too long (max %d bytes)", unitName, len(tx.AssetParams.UnitName))
+	return MakeAssetTransferTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, recipient, closeAssetsTo, amount, assetIndex, note)
+}
+
+// MakeAssetTransferTxnFromParams constructs an asset-transfer transaction
+// using the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetTransferTxn takes.
+func MakeAssetTransferTxnFromParams(account string, params types.SuggestedParams, recipient, closeAssetsTo string, amount uint64, assetIndex uint64, note []byte) (types.Transaction, error) {
+	return NewAssetTransferTxnBuilder().
+		Sender(account).
+		XferAsset(assetIndex).
+		AssetAmount(amount).
+		AssetReceiver(recipient).
+		AssetCloseTo(closeAssetsTo).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeAssetAcceptanceTxn creates a transaction that opts an account into an
+// asset, by way of a zero-amount self-transfer, using the passed parameters.
+func MakeAssetAcceptanceTxn(account string, assetIndex uint64, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	return MakeAssetTransferTxn(account, account, "", 0, assetIndex, feePerByte, firstRound, lastRound, note, genesisID, genesisHash)
+}
+
+// MakeAssetAcceptanceTxnWithFlatFee is as MakeAssetAcceptanceTxn, but
+// feePerByte is instead a flat fee.
+func MakeAssetAcceptanceTxnWithFlatFee(account string, assetIndex uint64, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	return MakeAssetTransferTxnWithFlatFee(account, account, "", 0, assetIndex, fee, firstRound, lastRound, note, genesisID, genesisHash)
+}
+
+// MakeAssetAcceptanceTxnFromParams is as MakeAssetTransferTxnFromParams, but
+// opts account into assetIndex via a zero-amount self-transfer.
+func MakeAssetAcceptanceTxnFromParams(account string, params types.SuggestedParams, assetIndex uint64, note []byte) (types.Transaction, error) {
+	return MakeAssetTransferTxnFromParams(account, params, account, "", 0, assetIndex, note)
+}
+
+// MakeAssetRevocationTxn creates a transaction that revokes assetholder's
+// holding of an asset, transferring amount to the clawback account's
+// recipient address. account must be the asset's clawback address.
+func MakeAssetRevocationTxn(account, assetholder string, amount uint64, recipient string, assetIndex uint64, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
-	copy(tx.AssetParams.UnitName[:], []byte(unitName))
 
-	if len(assetName) > len(tx.AssetParams.AssetName) {
-		return tx, fmt.Errorf("asset name %s too long (max %d bytes)", assetName, len(tx.AssetParams.AssetName))
+	return MakeAssetRevocationTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetholder, amount, recipient, assetIndex, note)
+}
+
+// MakeAssetRevocationTxnWithFlatFee is as MakeAssetRevocationTxn, but
+// feePerByte is instead a flat fee.
+func MakeAssetRevocationTxnWithFlatFee(account, assetholder string, amount uint64, recipient string, assetIndex uint64, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
-	copy(tx.AssetParams.AssetName[:], []byte(assetName))
 
-	// Fill in header
-	accountAddr, err := types.DecodeAddress(account)
+	return MakeAssetRevocationTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetholder, amount, recipient, assetIndex, note)
+}
+
+// MakeAssetRevocationTxnFromParams constructs an asset-revocation (clawback)
+// transaction using the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetRevocationTxn takes. account must
+// be the asset's clawback address.
+func MakeAssetRevocationTxnFromParams(account string, params types.SuggestedParams, assetholder string, amount uint64, recipient string, assetIndex uint64, note []byte) (types.Transaction, error) {
+	return NewAssetTransferTxnBuilder().
+		Sender(account).
+		AssetSender(assetholder).
+		XferAsset(assetIndex).
+		AssetAmount(amount).
+		AssetReceiver(recipient).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeAssetFreezeTxnFromParams constructs an asset-freeze transaction using
+// the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetFreezeTxn takes. account must be
+// the asset's freeze address.
+func MakeAssetFreezeTxnFromParams(account string, params types.SuggestedParams, assetIndex uint64, target string, newFreezeSetting bool, note []byte) (types.Transaction, error) {
+	return NewAssetFreezeTxnBuilder().
+		Sender(account).
+		FreezeAsset(assetIndex).
+		FreezeAccount(target).
+		AssetFrozen(newFreezeSetting).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeAssetFreezeTxn creates a transaction that freezes or unfreezes an
+// account's holding of an asset. account must be the asset's freeze address.
+func MakeAssetFreezeTxn(account string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string, assetIndex uint64, target string, newFreezeSetting bool) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
+
+	return MakeAssetFreezeTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, target, newFreezeSetting, note)
+}
+
+// MakeAssetFreezeTxnWithFlatFee is as MakeAssetFreezeTxn, but feePerByte is
+// instead a flat fee.
+func MakeAssetFreezeTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string, assetIndex uint64, target string, newFreezeSetting bool) (types.Transaction, error) {
 	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
-	tx.Header = types.Header{
-		Sender:      accountAddr,
-		Fee:         types.MicroAlgos(feePerByte),
-		FirstValid:  types.Round(firstRound),
-		LastValid:   types.Round(lastRound),
-		GenesisHash: types.Digest(ghBytes),
-		GenesisID:   genesisID,
-		Note:        note,
+
+	return MakeAssetFreezeTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, target, newFreezeSetting, note)
+}
+
+// MakeAssetConfigTxnFromParams constructs an asset-reconfiguration
+// transaction using the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetConfigTxn takes. See
+// MakeAssetConfigTxn for the semantics of the address arguments.
+func MakeAssetConfigTxnFromParams(account string, params types.SuggestedParams, assetIndex uint64, newManager, newReserve, newFreeze, newClawback string, note []byte) (types.Transaction, error) {
+	return NewAssetConfigTxnBuilder().
+		Sender(account).
+		ConfigAsset(assetIndex).
+		Manager(newManager).
+		Reserve(newReserve).
+		Freeze(newFreeze).
+		Clawback(newClawback).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeAssetConfigTxn creates a transaction that reconfigures an existing
+// asset's manager, reserve, freeze, and clawback addresses. account must be
+// the asset's manager. A reconfiguration transaction replaces all four
+// addresses at once; pass the current value of any address that should stay
+// the same, or an empty string to clear it permanently. Total, DefaultFrozen,
+// UnitName, AssetName, URL, and Decimals are immutable and cannot be changed
+// by this transaction.
+func MakeAssetConfigTxn(account string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string,
+	assetIndex uint64, newManager, newReserve, newFreeze, newClawback string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	// Update fee
-	eSize, err := estimateSize(tx)
+	return MakeAssetConfigTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, newManager, newReserve, newFreeze, newClawback, note)
+}
+
+// MakeAssetConfigTxnWithFlatFee is as MakeAssetConfigTxn, but feePerByte is
+// instead a flat fee.
+func MakeAssetConfigTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string,
+	assetIndex uint64, newManager, newReserve, newFreeze, newClawback string) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
-	tx.Fee = types.MicroAlgos(eSize * feePerByte)
 
-	return tx, nil
+	return MakeAssetConfigTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, newManager, newReserve, newFreeze, newClawback, note)
 }
 
-// MakeAssetCreateTxnWithFlatFee constructs an asset creation transaction using the passed parameters.
-// - account is a checksummed, human-readable address which will send the transaction.
-// - fee is fee per byte as received from algod SuggestedFee API call.
-// - firstRound is the first round this txn is valid (txn semantics unrelated to the asset)
-// - lastRound is the last round this txn is valid
-// - genesis id corresponds to the id of the network
-// - genesis hash corresponds to the base64-encoded hash of the genesis of the network
-// Asset creation parameters:
-// - see asset.go
-func MakeAssetCreateTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID string, genesisHash string,
-	total uint64, defaultFrozen bool, manager string, reserve string, freeze string, clawback string, unitName string, assetName string) (types.Transaction, error) {
-	tx, err := MakeAssetCreateTxn(account, fee, firstRound, lastRound, note, genesisID, genesisHash, total, defaultFrozen, manager, reserve, freeze, clawback, unitName, assetName)
+// MakeAssetDestroyTxnFromParams constructs an asset-destroy transaction
+// using the passed SuggestedParams, in place of the positional
+// fee/validity/genesis arguments MakeAssetDestroyTxn takes.
+func MakeAssetDestroyTxnFromParams(account string, params types.SuggestedParams, assetIndex uint64, note []byte) (types.Transaction, error) {
+	return NewAssetDestroyTxnBuilder().
+		Sender(account).
+		ConfigAsset(assetIndex).
+		Params(params).
+		Note(note).
+		Build()
+}
+
+// MakeAssetDestroyTxn creates a transaction that destroys an existing asset.
+// account must be the asset's manager. ConfigAsset is set to assetIndex and
+// AssetParams is left zeroed, as the protocol requires to destroy an asset.
+func MakeAssetDestroyTxn(account string, feePerByte, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string, assetIndex uint64) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
 	if err != nil {
 		return types.Transaction{}, err
 	}
 
-	tx.Fee = types.MicroAlgos(fee)
+	return MakeAssetDestroyTxnFromParams(account, types.SuggestedParams{
+		Fee:             feePerByte,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, note)
+}
 
-	if tx.Fee < MinTxnFee {
-		tx.Fee = MinTxnFee
+// MakeAssetDestroyTxnWithFlatFee is as MakeAssetDestroyTxn, but feePerByte is
+// instead a flat fee.
+func MakeAssetDestroyTxnWithFlatFee(account string, fee, firstRound, lastRound uint64, note []byte, genesisID, genesisHash string, assetIndex uint64) (types.Transaction, error) {
+	ghBytes, err := byte32FromBase64(genesisHash)
+	if err != nil {
+		return types.Transaction{}, err
 	}
 
-	return tx, nil
+	return MakeAssetDestroyTxnFromParams(account, types.SuggestedParams{
+		Fee:             fee,
+		FlatFee:         true,
+		FirstRoundValid: types.Round(firstRound),
+		LastRoundValid:  types.Round(lastRound),
+		GenesisID:       genesisID,
+		GenesisHash:     ghBytes[:],
+	}, assetIndex, note)
 }
 
 // AssignGroupID computes and return list of transactions with Group field set.
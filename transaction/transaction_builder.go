@@ -1,34 +1,342 @@
 package transaction
 
 import (
+	"fmt"
+
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
+// TransactionBuilder is the base, chainable builder embedded by every
+// transaction-specific builder in this package (PaymentTxnBuilder,
+// KeyRegTxnBuilder, the Asset* and Application* builders, ...). It
+// accumulates the header fields that are common to all transaction types
+// plus whichever type-specific fields its embedder populates, and produces
+// a types.Transaction via buildT.
+//
+// TransactionBuilder is not meant to be used directly; start from one of the
+// NewXxxTxnBuilder constructors instead.
 type TransactionBuilder struct {
+	txType types.TxType
+	err    error
+
+	sender      types.Address
+	senderSet   bool
+	feePerByte  uint64
+	flatFee     uint64
+	useFlatFee  bool
+	minFee      uint64
+	firstRound  uint64
+	lastRound   uint64
+	note        []byte
+	genesisID   string
+	genesisHash []byte
+
+	keyreg      types.KeyregTxnFields
+	payment     types.PaymentTxnFields
+	assetConfig types.AssetConfigTxnFields
+	assetXfer   types.AssetTransferTxnFields
+	assetFreeze types.AssetFreezeTxnFields
+	appCall     types.ApplicationCallTxnFields
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (tb *TransactionBuilder) Sender(sender string) *TransactionBuilder {
+	addr, err := types.DecodeAddress(sender)
+	if err != nil {
+		if tb.err == nil {
+			tb.err = err
+		}
+		return tb
+	}
+	tb.sender = addr
+	tb.senderSet = true
+	return tb
+}
+
+// SuggestedParams applies the common network parameters returned by algod's
+// suggested-parameters endpoint: the fee, first/last valid rounds, genesis
+// ID and genesis hash. feePerByte is interpreted as a fee-per-byte rate
+// unless FlatFee has also been called, in which case the flat fee wins.
+func (tb *TransactionBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *TransactionBuilder {
+	tb.feePerByte = feePerByte
+	tb.firstRound = firstRound
+	tb.lastRound = lastRound
+	tb.genesisID = genesisID
+	tb.genesisHash = genesisHash
+	return tb
+}
+
+// FlatFee switches the builder to flat-fee mode: fee is used as-is (subject
+// to MinTxnFee) instead of being multiplied by the estimated encoded
+// transaction size.
+func (tb *TransactionBuilder) FlatFee(fee uint64) *TransactionBuilder {
+	tb.useFlatFee = true
+	tb.flatFee = fee
+	return tb
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams
+// method: the fee, first/last valid rounds, genesis ID, genesis hash, and
+// FlatFee mode. sp.MinFee, if set, raises the fee floor applied in buildT
+// above the package's MinTxnFee, mirroring the minimum fee algod itself is
+// currently enforcing.
+func (tb *TransactionBuilder) Params(sp types.SuggestedParams) *TransactionBuilder {
+	tb.feePerByte = sp.Fee
+	tb.useFlatFee = sp.FlatFee
+	tb.flatFee = sp.Fee
+	tb.minFee = sp.MinFee
+	tb.firstRound = uint64(sp.FirstRoundValid)
+	tb.lastRound = uint64(sp.LastRoundValid)
+	tb.genesisID = sp.GenesisID
+	tb.genesisHash = sp.GenesisHash
+	return tb
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (tb *TransactionBuilder) Note(note []byte) *TransactionBuilder {
+	tb.note = note
+	return tb
+}
+
+// buildT assembles the Header and the currently-set type-specific fields
+// into a types.Transaction, computing the fee along the way. It is shared
+// by every concrete builder's Build method.
+func (tb *TransactionBuilder) buildT() (types.Transaction, error) {
+	if tb.err != nil {
+		return types.Transaction{}, tb.err
+	}
+	if !tb.senderSet {
+		return types.Transaction{}, fmt.Errorf("transaction sender must be set")
+	}
+	if len(tb.genesisHash) == 0 {
+		return types.Transaction{}, fmt.Errorf("transaction must contain a genesisHash")
+	}
+
+	var gh types.Digest
+	copy(gh[:], tb.genesisHash)
+
+	tx := types.Transaction{
+		Type: tb.txType,
+		Header: types.Header{
+			Sender:      tb.sender,
+			FirstValid:  types.Round(tb.firstRound),
+			LastValid:   types.Round(tb.lastRound),
+			Note:        tb.note,
+			GenesisID:   tb.genesisID,
+			GenesisHash: gh,
+		},
+		KeyregTxnFields:          tb.keyreg,
+		PaymentTxnFields:         tb.payment,
+		AssetConfigTxnFields:     tb.assetConfig,
+		AssetTransferTxnFields:   tb.assetXfer,
+		AssetFreezeTxnFields:     tb.assetFreeze,
+		ApplicationCallTxnFields: tb.appCall,
+	}
+
+	if tb.useFlatFee {
+		tx.Fee = types.MicroAlgos(tb.flatFee)
+	} else {
+		eSize, err := estimateSize(tx)
+		if err != nil {
+			return types.Transaction{}, err
+		}
+		tx.Fee = types.MicroAlgos(eSize * tb.feePerByte)
+	}
+
+	floor := uint64(MinTxnFee)
+	if tb.minFee > floor {
+		floor = tb.minFee
+	}
+	if uint64(tx.Fee) < floor {
+		tx.Fee = types.MicroAlgos(floor)
+	}
+
+	return tx, nil
+}
+
+// PaymentTxnBuilder builds a Payment transaction. Start one with
+// NewPaymentTxnBuilder.
+type PaymentTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewPaymentTxnBuilder starts a fluent builder for a Payment transaction.
+func NewPaymentTxnBuilder() *PaymentTxnBuilder {
+	b := &PaymentTxnBuilder{}
+	b.txType = types.PaymentTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *PaymentTxnBuilder) Sender(sender string) *PaymentTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *PaymentTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *PaymentTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *PaymentTxnBuilder) Params(sp types.SuggestedParams) *PaymentTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *PaymentTxnBuilder) FlatFee(fee uint64) *PaymentTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *PaymentTxnBuilder) Note(note []byte) *PaymentTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// Receiver sets the checksummed, human-readable address that will receive
+// the payment.
+func (b *PaymentTxnBuilder) Receiver(receiver string) *PaymentTxnBuilder {
+	addr, err := types.DecodeAddress(receiver)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.payment.Receiver = addr
+	return b
+}
+
+// Amount sets the number of microAlgos to send.
+func (b *PaymentTxnBuilder) Amount(amount uint64) *PaymentTxnBuilder {
+	b.payment.Amount = types.MicroAlgos(amount)
+	return b
+}
+
+// CloseRemainderTo closes out the sender's account, sending its entire
+// remaining balance to the given address, as part of this payment.
+func (b *PaymentTxnBuilder) CloseRemainderTo(closeRemainderTo string) *PaymentTxnBuilder {
+	if closeRemainderTo == "" {
+		return b
+	}
+	addr, err := types.DecodeAddress(closeRemainderTo)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.payment.CloseRemainderTo = addr
+	return b
+}
+
+// Build validates the required fields and returns the built Payment
+// transaction.
+func (b *PaymentTxnBuilder) Build() (types.Transaction, error) {
+	return b.buildT()
+}
+
+// KeyRegTxnBuilder builds a KeyReg (participation key registration)
+// transaction. Start one with NewKeyRegTxnBuilder.
+type KeyRegTxnBuilder struct {
+	TransactionBuilder
+}
+
+// NewKeyRegTxnBuilder starts a fluent builder for a KeyReg transaction.
+func NewKeyRegTxnBuilder() *KeyRegTxnBuilder {
+	b := &KeyRegTxnBuilder{}
+	b.txType = types.KeyRegistrationTx
+	return b
+}
+
+// Sender sets the checksummed, human-readable address of the account that
+// will sign and submit the transaction.
+func (b *KeyRegTxnBuilder) Sender(sender string) *KeyRegTxnBuilder {
+	b.TransactionBuilder.Sender(sender)
+	return b
+}
+
+// SuggestedParams applies the common network parameters: fee, validity
+// window, genesis ID and genesis hash.
+func (b *KeyRegTxnBuilder) SuggestedParams(feePerByte, firstRound, lastRound uint64, genesisID string, genesisHash []byte) *KeyRegTxnBuilder {
+	b.TransactionBuilder.SuggestedParams(feePerByte, firstRound, lastRound, genesisID, genesisHash)
+	return b
+}
+
+// Params applies a types.SuggestedParams as returned by algod's
+// suggested-parameters endpoint, in place of the positional SuggestedParams.
+func (b *KeyRegTxnBuilder) Params(sp types.SuggestedParams) *KeyRegTxnBuilder {
+	b.TransactionBuilder.Params(sp)
+	return b
+}
+
+// FlatFee switches the builder to flat-fee mode.
+func (b *KeyRegTxnBuilder) FlatFee(fee uint64) *KeyRegTxnBuilder {
+	b.TransactionBuilder.FlatFee(fee)
+	return b
+}
+
+// Note attaches an arbitrary byte array to the transaction.
+func (b *KeyRegTxnBuilder) Note(note []byte) *KeyRegTxnBuilder {
+	b.TransactionBuilder.Note(note)
+	return b
+}
+
+// VoteKey sets the base64-encoded root participation public key.
+func (b *KeyRegTxnBuilder) VoteKey(voteKey string) *KeyRegTxnBuilder {
+	bytes, err := byte32FromBase64(voteKey)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.keyreg.VotePK = types.VotePK(bytes)
+	return b
+}
+
+// SelectionKey sets the base64-encoded VRF public key.
+func (b *KeyRegTxnBuilder) SelectionKey(selectionKey string) *KeyRegTxnBuilder {
+	bytes, err := byte32FromBase64(selectionKey)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+	b.keyreg.SelectionPK = types.VRFPK(bytes)
+	return b
+}
+
+// VoteFirst sets the first round for which the participation key is valid.
+func (b *KeyRegTxnBuilder) VoteFirst(voteFirst uint64) *KeyRegTxnBuilder {
+	b.keyreg.VoteFirst = types.Round(voteFirst)
+	return b
+}
+
+// VoteLast sets the last round for which the participation key is valid.
+func (b *KeyRegTxnBuilder) VoteLast(voteLast uint64) *KeyRegTxnBuilder {
+	b.keyreg.VoteLast = types.Round(voteLast)
+	return b
+}
+
+// VoteKeyDilution sets the dilution for the two-level participation key.
+func (b *KeyRegTxnBuilder) VoteKeyDilution(voteKeyDilution uint64) *KeyRegTxnBuilder {
+	b.keyreg.VoteKeyDilution = voteKeyDilution
+	return b
+}
 
-	Type types.TxType
-
-	// Common fields for all types of transactions
-	types.Header
-
-	// Fields for different types of transactions
-	types.KeyregTxnFields
-	types.PaymentTxnFields
-	types.AssetConfigTxnFields
-	types.AssetTransferTxnFields
-	types.AssetFreezeTxnFields
-	
-	types.ApplicationCallTxnFields
-	
-}
-
-func (tb *TransactionBuilder) buildT()(tx *types.Transaction) {
-	tx.Type = tb.Type
-	tx.KeyregTxnFields = tb.KeyregTxnFields
-	tx.PaymentTxnFields = tb.PaymentTxnFields
-	tx.AssetConfigTxnFields = tb.AssetConfigTxnFields
-	tx.AssetTransferTxnFields = tb.AssetTransferTxnFields
-	tx.AssetFreezeTxnFields = tb.AssetFreezeTxnFields
-	tx.ApplicationCallTxnFields = tb.ApplicationCallTxnFields
-	return tx
+// Build validates the required fields and returns the built KeyReg
+// transaction.
+func (b *KeyRegTxnBuilder) Build() (types.Transaction, error) {
+	return b.buildT()
 }
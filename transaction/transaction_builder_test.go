@@ -0,0 +1,329 @@
+package transaction
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// validAddr and otherAddr are checksummed, human-readable addresses usable
+// anywhere a Sender/Receiver/etc. is required. genesisHash32 is a 32-byte
+// all-zero genesis hash, the shape every builder expects.
+var (
+	validAddr     = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"
+	otherAddr     = "AEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKE3PRHE"
+	genesisHash32 = make([]byte, 32)
+)
+
+func TestPaymentTxnBuilderValidation(t *testing.T) {
+	t.Run("missing sender", func(t *testing.T) {
+		_, err := NewPaymentTxnBuilder().
+			Receiver(validAddr).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected an error when sender is not set")
+		}
+	})
+
+	t.Run("missing genesis hash", func(t *testing.T) {
+		_, err := NewPaymentTxnBuilder().
+			Sender(validAddr).
+			Receiver(validAddr).
+			SuggestedParams(1, 1, 100, "", nil).
+			Build()
+		if err == nil {
+			t.Fatal("expected an error when genesis hash is not set")
+		}
+	})
+
+	t.Run("bad sender address", func(t *testing.T) {
+		_, err := NewPaymentTxnBuilder().
+			Sender("not-a-valid-address").
+			Receiver(validAddr).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected an error for a malformed sender address")
+		}
+	})
+}
+
+func TestPaymentTxnBuilderFeeModes(t *testing.T) {
+	t.Run("fee per byte", func(t *testing.T) {
+		tx, err := NewPaymentTxnBuilder().
+			Sender(validAddr).
+			Receiver(validAddr).
+			Amount(10).
+			SuggestedParams(10, 1, 100, "", genesisHash32).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.Fee == 0 {
+			t.Fatal("expected a non-zero fee-per-byte derived fee")
+		}
+	})
+
+	t.Run("flat fee below MinTxnFee is raised to the floor", func(t *testing.T) {
+		tx, err := NewPaymentTxnBuilder().
+			Sender(validAddr).
+			Receiver(validAddr).
+			Amount(10).
+			SuggestedParams(0, 1, 100, "", genesisHash32).
+			FlatFee(1).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if uint64(tx.Fee) != MinTxnFee {
+			t.Fatalf("expected fee to be raised to MinTxnFee (%d), got %d", MinTxnFee, tx.Fee)
+		}
+	})
+
+	t.Run("flat fee above MinTxnFee is used as-is", func(t *testing.T) {
+		tx, err := NewPaymentTxnBuilder().
+			Sender(validAddr).
+			Receiver(validAddr).
+			Amount(10).
+			SuggestedParams(0, 1, 100, "", genesisHash32).
+			FlatFee(5000).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if uint64(tx.Fee) != 5000 {
+			t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+		}
+	})
+
+	t.Run("Params MinFee raises the floor above MinTxnFee", func(t *testing.T) {
+		tx, err := NewPaymentTxnBuilder().
+			Sender(validAddr).
+			Receiver(validAddr).
+			Amount(10).
+			Params(types.SuggestedParams{
+				FlatFee:         true,
+				Fee:             1,
+				FirstRoundValid: 1,
+				LastRoundValid:  100,
+				GenesisHash:     genesisHash32,
+				MinFee:          2000,
+			}).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if uint64(tx.Fee) != 2000 {
+			t.Fatalf("expected MinFee (2000) to win over flat fee (1), got %d", tx.Fee)
+		}
+	})
+}
+
+func TestKeyRegTxnBuilder(t *testing.T) {
+	tx, err := NewKeyRegTxnBuilder().
+		Sender(validAddr).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Type != types.KeyRegistrationTx {
+		t.Fatalf("expected a KeyReg transaction, got %v", tx.Type)
+	}
+}
+
+func TestAssetCreateTxnBuilderNameLimits(t *testing.T) {
+	tooLong := strings.Repeat("x", 100)
+
+	cases := []struct {
+		name  string
+		apply func(*AssetCreateTxnBuilder) *AssetCreateTxnBuilder
+	}{
+		{"unit name too long", func(b *AssetCreateTxnBuilder) *AssetCreateTxnBuilder { return b.UnitName(tooLong) }},
+		{"asset name too long", func(b *AssetCreateTxnBuilder) *AssetCreateTxnBuilder { return b.AssetName(tooLong) }},
+		{"url too long", func(b *AssetCreateTxnBuilder) *AssetCreateTxnBuilder { return b.URL(tooLong) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := NewAssetCreateTxnBuilder().
+				Sender(validAddr).
+				Total(10).
+				SuggestedParams(1, 1, 100, "", genesisHash32)
+			c.apply(b)
+			if _, err := b.Build(); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestAssetCreateTxnBuilderAddressFields(t *testing.T) {
+	t.Run("empty address is skipped, not an error", func(t *testing.T) {
+		tx, err := NewAssetCreateTxnBuilder().
+			Sender(validAddr).
+			Total(10).
+			Manager("").
+			Reserve("").
+			Freeze("").
+			Clawback("").
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.AssetParams.Manager != (types.Address{}) {
+			t.Fatal("expected Manager to stay zeroed when given an empty string")
+		}
+	})
+
+	t.Run("malformed address is an error", func(t *testing.T) {
+		_, err := NewAssetCreateTxnBuilder().
+			Sender(validAddr).
+			Total(10).
+			Manager("not-a-valid-address").
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected an error for a malformed manager address")
+		}
+	})
+}
+
+func TestAssetConfigTxnBuilderRequiresConfigAsset(t *testing.T) {
+	_, err := NewAssetConfigTxnBuilder().
+		Sender(validAddr).
+		Manager(validAddr).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when ConfigAsset is not set")
+	}
+}
+
+func TestAssetDestroyTxnBuilderZeroesAssetParams(t *testing.T) {
+	t.Run("requires ConfigAsset", func(t *testing.T) {
+		_, err := NewAssetDestroyTxnBuilder().
+			Sender(validAddr).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected an error when ConfigAsset is not set")
+		}
+	})
+
+	t.Run("AssetParams is zeroed", func(t *testing.T) {
+		tx, err := NewAssetDestroyTxnBuilder().
+			Sender(validAddr).
+			ConfigAsset(7).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.AssetParams != (types.AssetParams{}) {
+			t.Fatal("expected AssetParams to be zeroed on a destroy transaction")
+		}
+	})
+}
+
+func TestAssetTransferTxnBuilderRequiresXferAsset(t *testing.T) {
+	_, err := NewAssetTransferTxnBuilder().
+		Sender(validAddr).
+		AssetReceiver(validAddr).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when XferAsset is not set")
+	}
+}
+
+func TestAssetFreezeTxnBuilderRequiresFreezeAsset(t *testing.T) {
+	_, err := NewAssetFreezeTxnBuilder().
+		Sender(validAddr).
+		FreezeAccount(validAddr).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when FreezeAsset is not set")
+	}
+}
+
+func TestApplicationCallTxnBuilderOptInScoping(t *testing.T) {
+	t.Run("allowed on the create builder", func(t *testing.T) {
+		_, err := NewApplicationCreateTxnBuilder().
+			Sender(validAddr).
+			ApprovalProgram([]byte{1}).
+			ClearStateProgram([]byte{1}).
+			OptIn(true).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("rejected on a delete builder", func(t *testing.T) {
+		_, err := NewApplicationDeleteTxnBuilder().
+			Sender(validAddr).
+			ApplicationID(1).
+			OptIn(true).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected OptIn on a non-create builder to error out, not silently overwrite OnCompletion")
+		}
+	})
+
+	t.Run("rejected on an update builder", func(t *testing.T) {
+		_, err := NewApplicationUpdateTxnBuilder().
+			Sender(validAddr).
+			ApplicationID(1).
+			ApprovalProgram([]byte{1}).
+			ClearStateProgram([]byte{1}).
+			OptIn(true).
+			SuggestedParams(1, 1, 100, "", genesisHash32).
+			Build()
+		if err == nil {
+			t.Fatal("expected OptIn on the update builder to error out, not downgrade OnCompletion to NoOp")
+		}
+	})
+}
+
+func TestApplicationCallTxnBuilderCreationRequiresPrograms(t *testing.T) {
+	_, err := NewApplicationCreateTxnBuilder().
+		Sender(validAddr).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when creating an application without an approval/clear program")
+	}
+}
+
+func TestApplicationCallTxnBuilderProgramTooLong(t *testing.T) {
+	tooLong := make([]byte, MaxAppProgramLen+1)
+	_, err := NewApplicationCreateTxnBuilder().
+		Sender(validAddr).
+		ApprovalProgram(tooLong).
+		ClearStateProgram([]byte{1}).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when the approval program exceeds MaxAppProgramLen")
+	}
+}
+
+func TestApplicationCallTxnBuilderSchemaTooLarge(t *testing.T) {
+	_, err := NewApplicationCreateTxnBuilder().
+		Sender(validAddr).
+		ApprovalProgram([]byte{1}).
+		ClearStateProgram([]byte{1}).
+		GlobalStateSchema(types.StateSchema{NumUint: MaxAppGlobalSchemaEntries + 1}).
+		SuggestedParams(1, 1, 100, "", genesisHash32).
+		Build()
+	if err == nil {
+		t.Fatal("expected an error when GlobalStateSchema exceeds MaxAppGlobalSchemaEntries")
+	}
+}
@@ -0,0 +1,144 @@
+package transaction
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+func TestMakePaymentTxnFromParamsMatchesPositionalForm(t *testing.T) {
+	viaParams, err := MakePaymentTxnFromParams(validAddr, types.SuggestedParams{
+		Fee:             10,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+	}, otherAddr, 5, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	viaPositional, err := MakePaymentTxn(validAddr, otherAddr, 10, 5, 1, 100, nil, "", "", genesisHash32)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(viaParams, viaPositional) {
+		t.Fatalf("expected MakePaymentTxn to produce the same transaction as MakePaymentTxnFromParams:\n%+v\n%+v", viaParams, viaPositional)
+	}
+}
+
+func TestMakePaymentTxnWithFlatFeeUsesFlatFeeParams(t *testing.T) {
+	tx, err := MakePaymentTxnWithFlatFee(validAddr, otherAddr, 5000, 5, 1, 100, nil, "", "", genesisHash32)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 5000 {
+		t.Fatalf("expected flat fee of 5000, got %d", tx.Fee)
+	}
+}
+
+func TestMakeKeyRegTxnFromParamsMatchesPositionalForm(t *testing.T) {
+	viaParams, err := MakeKeyRegTxnFromParams(validAddr, types.SuggestedParams{
+		Fee:             10,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+	}, genesisHashB64, genesisHashB64, 1, 100, 10, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	viaPositional, err := MakeKeyRegTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, genesisHashB64, genesisHashB64, 1, 100, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(viaParams, viaPositional) {
+		t.Fatalf("expected MakeKeyRegTxn to produce the same transaction as MakeKeyRegTxnFromParams:\n%+v\n%+v", viaParams, viaPositional)
+	}
+}
+
+func TestMakeAssetCreateTxnFromParamsMatchesPositionalForm(t *testing.T) {
+	viaParams, err := MakeAssetCreateTxnFromParams(validAddr, types.SuggestedParams{
+		Fee:             10,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+	}, 100, false, validAddr, validAddr, validAddr, validAddr, "U", "Asset", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	viaPositional, err := MakeAssetCreateTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 100, false, validAddr, validAddr, validAddr, validAddr, "U", "Asset")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(viaParams, viaPositional) {
+		t.Fatalf("expected MakeAssetCreateTxn to produce the same transaction as MakeAssetCreateTxnFromParams:\n%+v\n%+v", viaParams, viaPositional)
+	}
+}
+
+func TestMakeAssetDestroyTxnFromParamsMatchesPositionalForm(t *testing.T) {
+	viaParams, err := MakeAssetDestroyTxnFromParams(validAddr, types.SuggestedParams{
+		Fee:             10,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+	}, 5, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	viaPositional, err := MakeAssetDestroyTxn(validAddr, 10, 1, 100, nil, "", genesisHashB64, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(viaParams, viaPositional) {
+		t.Fatalf("expected MakeAssetDestroyTxn to produce the same transaction as MakeAssetDestroyTxnFromParams:\n%+v\n%+v", viaParams, viaPositional)
+	}
+}
+
+func TestMakeApplicationCreateTxnFromParamsMatchesPositionalForm(t *testing.T) {
+	approval := []byte{1}
+	clearState := []byte{1}
+
+	viaParams, err := MakeApplicationCreateTxnFromParams(validAddr, types.SuggestedParams{
+		Fee:             10,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+	}, approval, clearState, types.StateSchema{}, types.StateSchema{}, nil, nil, nil, nil, 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	viaPositional, err := MakeApplicationCreateTxn(validAddr, 10, 1, 100, nil, "", genesisHash32,
+		approval, clearState, types.StateSchema{}, types.StateSchema{}, nil, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(viaParams, viaPositional) {
+		t.Fatalf("expected MakeApplicationCreateTxn to produce the same transaction as MakeApplicationCreateTxnFromParams:\n%+v\n%+v", viaParams, viaPositional)
+	}
+}
+
+func TestFromParamsEnforcesMinFeeFloor(t *testing.T) {
+	tx, err := MakePaymentTxnFromParams(validAddr, types.SuggestedParams{
+		FlatFee:         true,
+		Fee:             1,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisHash32,
+		MinFee:          3000,
+	}, otherAddr, 5, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uint64(tx.Fee) != 3000 {
+		t.Fatalf("expected SuggestedParams.MinFee (3000) to raise the floor, got fee %d", tx.Fee)
+	}
+}